@@ -0,0 +1,63 @@
+// GET/PATCH /api/v1/config: read and hot-patch server settings. PATCH uses
+// If-Match against the handler's fingerprint for optimistic concurrency, the
+// same idea as an HTTP ETag.
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"usersvc/config"
+)
+
+// ConfigHandler exposes a config.ConfigHandler over HTTP.
+type ConfigHandler struct {
+	config config.ConfigHandler
+}
+
+// NewConfigHandler creates a new config handler.
+func NewConfigHandler(cfg config.ConfigHandler) *ConfigHandler {
+	return &ConfigHandler{config: cfg}
+}
+
+func (h *ConfigHandler) Get(w http.ResponseWriter, r *http.Request) {
+	data, err := h.config.Marshal()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", h.config.Fingerprint())
+	w.Write(data)
+}
+
+func (h *ConfigHandler) Patch(w http.ResponseWriter, r *http.Request) {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" {
+		http.Error(w, "Missing If-Match header", http.StatusPreconditionRequired)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "Invalid body", http.StatusBadRequest)
+		return
+	}
+
+	err = h.config.DoLockedAction(ifMatch, func(s *config.Settings) error {
+		return json.Unmarshal(body, s)
+	})
+	switch {
+	case errors.Is(err, config.ErrFingerprintMismatch):
+		http.Error(w, "Fingerprint mismatch", http.StatusPreconditionFailed)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.Get(w, r)
+}
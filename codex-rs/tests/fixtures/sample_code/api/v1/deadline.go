@@ -0,0 +1,49 @@
+// deadlineTimer gives a single long-lived request (the NDJSON stream) a
+// resettable per-iteration deadline using one timer, the same pattern
+// netstack's gonet adapter uses to implement net.Conn read/write deadlines
+// without spinning up a goroutine per deadline.
+package v1
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type deadlineTimer struct {
+	mu     sync.Mutex
+	timer  *time.Timer
+	cancel context.CancelFunc
+}
+
+// newDeadlineTimer derives a cancelable context from parent; canceling it is
+// how an expired deadline aborts in-flight iteration.
+func newDeadlineTimer(parent context.Context) (*deadlineTimer, context.Context) {
+	ctx, cancel := context.WithCancel(parent)
+	return &deadlineTimer{cancel: cancel}, ctx
+}
+
+// setDeadline arms the timer to cancel the context after d, replacing any
+// previously armed timer so at most one is ever pending.
+func (t *deadlineTimer) setDeadline(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	t.timer = time.AfterFunc(d, t.cancel)
+}
+
+// stop disarms the timer and releases the context, so a client that
+// finishes (or disconnects) doesn't leave the timer's goroutine pending.
+func (t *deadlineTimer) stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+	t.cancel()
+}
@@ -0,0 +1,229 @@
+// Package v1 exposes UserService over JSON/REST. It owns transport concerns
+// only (decoding, status codes, route wiring) and defers everything else to
+// service.UserService.
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"usersvc/auth"
+	"usersvc/config"
+	"usersvc/service"
+)
+
+// UserHandler handles HTTP requests for user operations
+type UserHandler struct {
+	Service service.UserService
+	Config  config.ConfigHandler
+}
+
+// NewUserHandler creates a new user handler
+func NewUserHandler(svc service.UserService, cfg config.ConfigHandler) *UserHandler {
+	return &UserHandler{Service: svc, Config: cfg}
+}
+
+func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	var user service.User
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	// Only an admin may hand out roles on create; everyone else gets
+	// CreateUser's default ("user") regardless of what the body asked for.
+	if !isAdmin(r) {
+		user.Roles = nil
+	}
+
+	if err := h.Service.CreateUser(r.Context(), &user); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(&user)
+}
+
+// authorizeSelfOrAdmin reports whether the caller's JWT claims allow access
+// to the record identified by id: admins may access any record, everyone
+// else only their own.
+func authorizeSelfOrAdmin(r *http.Request, id int) bool {
+	claims, ok := auth.ClaimsFromContext(r.Context())
+	if !ok {
+		return false
+	}
+	return claims.UserID == id || isAdmin(r)
+}
+
+// isAdmin reports whether the caller's JWT claims include the admin role.
+func isAdmin(r *http.Request) bool {
+	claims, ok := auth.ClaimsFromContext(r.Context())
+	if !ok {
+		return false
+	}
+	for _, role := range claims.Roles {
+		if role == "admin" {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *UserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		return
+	}
+
+	if !authorizeSelfOrAdmin(r, id) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	user, err := h.Service.GetUser(r.Context(), id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(user)
+}
+
+func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		return
+	}
+
+	if !authorizeSelfOrAdmin(r, id) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	var user service.User
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	// PasswordHash is never in the request body (json:"-"); preserve the
+	// stored one. Roles are only preserved from the request for admins, so
+	// a self-update can't smuggle in a role change.
+	current, err := h.Service.GetUser(r.Context(), id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	user.PasswordHash = current.PasswordHash
+	if !isAdmin(r) {
+		user.Roles = current.Roles
+	}
+
+	user.ID = id
+	if err := h.Service.UpdateUser(r.Context(), &user); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(&user)
+}
+
+func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Service.DeleteUser(r.Context(), id); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListUsersPageResponse is returned instead of a bare array when the request
+// uses cursor pagination, so the client has somewhere to read next_cursor.
+type ListUsersPageResponse struct {
+	Users      []*service.User `json:"users"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
+
+func (h *UserHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	settings := h.Config.Settings()
+
+	limit := settings.DefaultPageSize
+	if l, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	if settings.MaxPageSize > 0 && limit > settings.MaxPageSize {
+		limit = settings.MaxPageSize
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	// Cursor pagination takes precedence over offset/limit when requested.
+	if r.URL.Query().Has("cursor") {
+		cursor := r.URL.Query().Get("cursor")
+		users, nextCursor, err := h.Service.ListUsersPage(r.Context(), cursor, limit)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(ListUsersPageResponse{Users: users, NextCursor: nextCursor})
+		return
+	}
+
+	offset := 0 // default
+	if o, err := strconv.Atoi(r.URL.Query().Get("offset")); err == nil && o >= 0 {
+		offset = o
+	}
+
+	users, err := h.Service.ListUsers(r.Context(), limit, offset)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(users)
+}
+
+// Routes mounts the v1 REST API, plus auth/register, auth/login and
+// auth/refresh, onto r. Every route other than the three auth endpoints
+// requires a valid bearer token; ListUsers, StreamListUsers, DeleteUser and
+// GET/PATCH /config additionally require the admin role.
+func Routes(r *mux.Router, handler *UserHandler, authHandler *AuthHandler, configHandler *ConfigHandler, issuer *auth.Issuer) {
+	authRoutes := r.PathPrefix("/api/v1/auth").Subrouter()
+	authRoutes.HandleFunc("/register", authHandler.Register).Methods("POST")
+	authRoutes.HandleFunc("/login", authHandler.Login).Methods("POST")
+	authRoutes.HandleFunc("/refresh", authHandler.Refresh).Methods("POST")
+
+	api := r.PathPrefix("/api/v1").Subrouter()
+	api.Use(auth.Authenticate(issuer))
+	api.HandleFunc("/users", handler.CreateUser).Methods("POST")
+	api.Handle("/users", auth.RequireRole("admin")(http.HandlerFunc(handler.ListUsers))).Methods("GET")
+	api.Handle("/users:stream", auth.RequireRole("admin")(http.HandlerFunc(handler.StreamListUsers))).Methods("GET")
+	api.HandleFunc("/users/{id:[0-9]+}", handler.GetUser).Methods("GET")
+	api.HandleFunc("/users/{id:[0-9]+}", handler.UpdateUser).Methods("PUT")
+	api.Handle("/users/{id:[0-9]+}", auth.RequireRole("admin")(http.HandlerFunc(handler.DeleteUser))).Methods("DELETE")
+	api.Handle("/config", auth.RequireRole("admin")(http.HandlerFunc(configHandler.Get))).Methods("GET")
+	api.Handle("/config", auth.RequireRole("admin")(http.HandlerFunc(configHandler.Patch))).Methods("PATCH")
+}
@@ -0,0 +1,72 @@
+// Streaming variant of ListUsers for clients that want to consume very
+// large user sets without pulling the whole thing into memory.
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// streamIterationTimeout bounds how long StreamListUsers may go between
+// successfully fetching and flushing a page before it gives up and closes
+// the connection.
+const streamIterationTimeout = 30 * time.Second
+
+const streamPageSize = 100
+
+// StreamListUsers emits newline-delimited JSON user records, paging through
+// the full set with ListUsersPage and flushing after every page so clients
+// can start consuming before the whole set has been read.
+func (h *UserHandler) StreamListUsers(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	dt, ctx := newDeadlineTimer(r.Context())
+	defer dt.stop()
+
+	// The server's WriteTimeout is sized for ordinary request/response
+	// handlers, not a connection that's meant to stay open for as long as
+	// there's data left to page through. Push the per-write deadline out
+	// on every iteration so streamIterationTimeout - not WriteTimeout - is
+	// what actually bounds this handler.
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	enc := json.NewEncoder(w)
+	cursor := r.URL.Query().Get("cursor")
+
+	for {
+		dt.setDeadline(streamIterationTimeout)
+		// Best effort: only http.ErrNotSupported can come back here, for a
+		// ResponseWriter that doesn't implement deadline control.
+		_ = rc.SetWriteDeadline(time.Now().Add(streamIterationTimeout))
+
+		users, next, err := h.Service.ListUsersPage(ctx, cursor, streamPageSize)
+		if err != nil {
+			// ctx.Err() != nil means the client went away or a deadline
+			// fired mid-iteration; either way there's nothing useful left
+			// to write to the response.
+			if ctx.Err() != nil {
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		for _, user := range users {
+			if err := enc.Encode(user); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+
+		if next == "" {
+			return
+		}
+		cursor = next
+	}
+}
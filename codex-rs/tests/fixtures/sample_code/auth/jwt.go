@@ -0,0 +1,125 @@
+// Package auth issues and validates the JWTs that protect the user routes.
+package auth
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Algorithm selects the signing algorithm an Issuer uses.
+type Algorithm string
+
+const (
+	HS256 Algorithm = "HS256"
+	RS256 Algorithm = "RS256"
+)
+
+// Config configures an Issuer. Exactly one of HMACSecret or the RSA key pair
+// must be set, matching Algorithm.
+type Config struct {
+	Algorithm     Algorithm
+	HMACSecret    []byte
+	RSAPrivateKey *rsa.PrivateKey
+	RSAPublicKey  *rsa.PublicKey
+	AccessTTL     time.Duration
+	RefreshTTL    time.Duration
+	Issuer        string
+}
+
+// Claims are the custom claims embedded in every access token issued by this
+// package; downstream handlers read UserID/Roles off the request context.
+type Claims struct {
+	UserID int      `json:"uid"`
+	Roles  []string `json:"roles"`
+	jwt.RegisteredClaims
+}
+
+// Issuer mints and validates JWTs for a single signing configuration.
+type Issuer struct {
+	cfg Config
+}
+
+// NewIssuer validates cfg and returns an Issuer.
+func NewIssuer(cfg Config) (*Issuer, error) {
+	switch cfg.Algorithm {
+	case HS256:
+		if len(cfg.HMACSecret) == 0 {
+			return nil, fmt.Errorf("auth: HS256 requires HMACSecret")
+		}
+	case RS256:
+		if cfg.RSAPrivateKey == nil || cfg.RSAPublicKey == nil {
+			return nil, fmt.Errorf("auth: RS256 requires an RSA key pair")
+		}
+	default:
+		return nil, fmt.Errorf("auth: unsupported algorithm %q", cfg.Algorithm)
+	}
+	if cfg.AccessTTL == 0 {
+		cfg.AccessTTL = 15 * time.Minute
+	}
+	if cfg.RefreshTTL == 0 {
+		cfg.RefreshTTL = 7 * 24 * time.Hour
+	}
+	return &Issuer{cfg: cfg}, nil
+}
+
+// IssueAccessToken returns a signed access token for userID/roles.
+func (i *Issuer) IssueAccessToken(userID int, roles []string) (string, error) {
+	return i.sign(userID, roles, i.cfg.AccessTTL)
+}
+
+// IssueRefreshToken returns a signed, longer-lived refresh token. Refresh
+// tokens carry no roles so a stolen one can't be used directly against
+// role-gated routes.
+func (i *Issuer) IssueRefreshToken(userID int) (string, error) {
+	return i.sign(userID, nil, i.cfg.RefreshTTL)
+}
+
+func (i *Issuer) sign(userID int, roles []string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		UserID: userID,
+		Roles:  roles,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    i.cfg.Issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+
+	var method jwt.SigningMethod
+	var key any
+	switch i.cfg.Algorithm {
+	case HS256:
+		method, key = jwt.SigningMethodHS256, i.cfg.HMACSecret
+	case RS256:
+		method, key = jwt.SigningMethodRS256, i.cfg.RSAPrivateKey
+	}
+
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		return "", fmt.Errorf("auth: sign token: %w", err)
+	}
+	return token, nil
+}
+
+// Parse validates tokenString and returns its claims.
+func (i *Issuer) Parse(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
+		switch i.cfg.Algorithm {
+		case HS256:
+			return i.cfg.HMACSecret, nil
+		case RS256:
+			return i.cfg.RSAPublicKey, nil
+		default:
+			return nil, fmt.Errorf("auth: unsupported algorithm %q", i.cfg.Algorithm)
+		}
+	}, jwt.WithValidMethods([]string{string(i.cfg.Algorithm)}))
+	if err != nil {
+		return nil, fmt.Errorf("auth: parse token: %w", err)
+	}
+	return claims, nil
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+type contextKey string
+
+const claimsContextKey contextKey = "auth.claims"
+
+// Authenticate extracts and validates the bearer token on every request,
+// stashing its Claims in the request context for downstream handlers and
+// RequireRole to read.
+func Authenticate(issuer *Issuer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			token, ok := strings.CutPrefix(header, "Bearer ")
+			if !ok || token == "" {
+				http.Error(w, "Missing bearer token", http.StatusUnauthorized)
+				return
+			}
+
+			claims, err := issuer.Parse(token)
+			if err != nil {
+				http.Error(w, "Invalid or expired token", http.StatusUnauthorized)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), claimsContextKey, claims)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// ClaimsFromContext returns the Claims stashed by Authenticate or
+// ContextWithClaims, if any.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey).(*Claims)
+	return claims, ok
+}
+
+// ContextWithClaims stashes claims under the same key ClaimsFromContext
+// reads. Transports that don't go through the Authenticate middleware (the
+// gRPC interceptors) use this to make claims available the same way.
+func ContextWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey, claims)
+}
+
+// RequireRole rejects requests whose claims don't include at least one of
+// roles. Authenticate must run earlier in the chain.
+func RequireRole(roles ...string) func(http.Handler) http.Handler {
+	allowed := make(map[string]struct{}, len(roles))
+	for _, role := range roles {
+		allowed[role] = struct{}{}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := ClaimsFromContext(r.Context())
+			if !ok {
+				http.Error(w, "Missing authentication", http.StatusUnauthorized)
+				return
+			}
+			for _, role := range claims.Roles {
+				if _, ok := allowed[role]; ok {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+			http.Error(w, "Insufficient role", http.StatusForbidden)
+		})
+	}
+}
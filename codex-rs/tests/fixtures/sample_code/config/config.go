@@ -0,0 +1,245 @@
+// Package config holds the server's hot-reloadable settings (listen
+// address, timeouts, pagination defaults, auth secrets) behind a
+// ConfigHandler so they can change at runtime, with fingerprints giving
+// callers optimistic-concurrency control over updates.
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Settings is every piece of configuration the ConfigHandler holds. The
+// server picks up changes to the timeouts and page sizes without a restart;
+// ListenAddr and the auth settings are read only once, at startup.
+type Settings struct {
+	ListenAddr      string        `json:"listen_addr" yaml:"listen_addr"`
+	ReadTimeout     time.Duration `json:"read_timeout" yaml:"read_timeout"`
+	WriteTimeout    time.Duration `json:"write_timeout" yaml:"write_timeout"`
+	IdleTimeout     time.Duration `json:"idle_timeout" yaml:"idle_timeout"`
+	DefaultPageSize int           `json:"default_page_size" yaml:"default_page_size"`
+	MaxPageSize     int           `json:"max_page_size" yaml:"max_page_size"`
+	AuthAlgorithm   string        `json:"auth_algorithm" yaml:"auth_algorithm"`
+	AuthHMACSecret  string        `json:"auth_hmac_secret" yaml:"auth_hmac_secret"`
+}
+
+// Default returns the settings the server starts with before any config
+// file or PATCH is applied.
+func Default() Settings {
+	return Settings{
+		ListenAddr:      ":8080",
+		ReadTimeout:     15 * time.Second,
+		WriteTimeout:    15 * time.Second,
+		IdleTimeout:     60 * time.Second,
+		DefaultPageSize: 10,
+		MaxPageSize:     100,
+		AuthAlgorithm:   "HS256",
+	}
+}
+
+// ErrFingerprintMismatch is returned by DoLockedAction when fp no longer
+// matches the handler's current settings.
+var ErrFingerprintMismatch = errors.New("config: fingerprint mismatch")
+
+// ConfigHandler is a concurrency-safe, hot-reloadable holder of Settings.
+type ConfigHandler interface {
+	Settings() Settings
+	Fingerprint() string
+
+	Marshal() ([]byte, error)
+	Unmarshal(data []byte) error
+	MarshalYAML() ([]byte, error)
+	UnmarshalYAML(data []byte) error
+
+	// MarshalJSONPath/UnmarshalJSONPath address a single top-level field by
+	// its JSON tag (e.g. "read_timeout"), for callers that want to read or
+	// change one setting without round-tripping the whole document.
+	MarshalJSONPath(path string) ([]byte, error)
+	UnmarshalJSONPath(path string, data []byte) error
+
+	// DoLockedAction runs cb against a copy of the current Settings while
+	// holding the handler's lock, committing the result only if fp still
+	// matches Fingerprint() -- this is what gives PATCH /api/v1/config its
+	// If-Match semantics.
+	DoLockedAction(fp string, cb func(*Settings) error) error
+
+	// OnChange registers fn to run after every successful change, so
+	// dependents (e.g. the HTTP server re-reading timeouts) don't have to
+	// poll.
+	OnChange(fn func(Settings))
+}
+
+type handler struct {
+	mu       sync.RWMutex
+	settings Settings
+	watchers []func(Settings)
+}
+
+// New returns a ConfigHandler seeded with settings.
+func New(settings Settings) ConfigHandler {
+	return &handler{settings: settings}
+}
+
+func (h *handler) Settings() Settings {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.settings
+}
+
+func (h *handler) Fingerprint() string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return fingerprint(h.settings)
+}
+
+func fingerprint(s Settings) string {
+	data, _ := json.Marshal(s)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func (h *handler) Marshal() ([]byte, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return json.Marshal(h.settings)
+}
+
+func (h *handler) Unmarshal(data []byte) error {
+	var s Settings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("config: unmarshal json: %w", err)
+	}
+	h.replace(s)
+	return nil
+}
+
+func (h *handler) MarshalYAML() ([]byte, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return yaml.Marshal(h.settings)
+}
+
+func (h *handler) UnmarshalYAML(data []byte) error {
+	var s Settings
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("config: unmarshal yaml: %w", err)
+	}
+	h.replace(s)
+	return nil
+}
+
+func (h *handler) MarshalJSONPath(path string) ([]byte, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	tree, err := settingsTree(h.settings)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := tree[path]
+	if !ok {
+		return nil, fmt.Errorf("config: unknown path %q", path)
+	}
+	return value, nil
+}
+
+func (h *handler) UnmarshalJSONPath(path string, data []byte) error {
+	h.mu.Lock()
+
+	tree, err := settingsTree(h.settings)
+	if err != nil {
+		h.mu.Unlock()
+		return err
+	}
+	if _, ok := tree[path]; !ok {
+		h.mu.Unlock()
+		return fmt.Errorf("config: unknown path %q", path)
+	}
+	tree[path] = json.RawMessage(data)
+
+	merged, err := json.Marshal(tree)
+	if err != nil {
+		h.mu.Unlock()
+		return err
+	}
+	var s Settings
+	if err := json.Unmarshal(merged, &s); err != nil {
+		h.mu.Unlock()
+		return fmt.Errorf("config: apply path %q: %w", path, err)
+	}
+	snapshot, watchers := h.settingsLocked(s)
+	h.mu.Unlock()
+	notify(snapshot, watchers)
+	return nil
+}
+
+func settingsTree(s Settings) (map[string]json.RawMessage, error) {
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	var tree map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &tree); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+func (h *handler) DoLockedAction(fp string, cb func(*Settings) error) error {
+	h.mu.Lock()
+
+	if fingerprint(h.settings) != fp {
+		h.mu.Unlock()
+		return ErrFingerprintMismatch
+	}
+	updated := h.settings
+	if err := cb(&updated); err != nil {
+		h.mu.Unlock()
+		return err
+	}
+	snapshot, watchers := h.settingsLocked(updated)
+	h.mu.Unlock()
+	notify(snapshot, watchers)
+	return nil
+}
+
+// replace swaps in s and notifies watchers; it takes its own lock so it's
+// safe to call from Unmarshal/UnmarshalYAML, which aren't otherwise holding one.
+func (h *handler) replace(s Settings) {
+	h.mu.Lock()
+	snapshot, watchers := h.settingsLocked(s)
+	h.mu.Unlock()
+	notify(snapshot, watchers)
+}
+
+// settingsLocked swaps in s and returns it along with a snapshot of the
+// registered watchers; callers must hold h.mu and must call notify only
+// after releasing it (see notify).
+func (h *handler) settingsLocked(s Settings) (Settings, []func(Settings)) {
+	h.settings = s
+	watchers := make([]func(Settings), len(h.watchers))
+	copy(watchers, h.watchers)
+	return s, watchers
+}
+
+// notify runs each watcher with s. It must be called without h.mu held:
+// a watcher (e.g. main's timeout reload, which calls Fingerprint) may call
+// back into the handler, and sync.RWMutex isn't reentrant.
+func notify(s Settings, watchers []func(Settings)) {
+	for _, w := range watchers {
+		w(s)
+	}
+}
+
+func (h *handler) OnChange(fn func(Settings)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.watchers = append(h.watchers, fn)
+}
@@ -0,0 +1,117 @@
+// Auth for the gRPC transport: a bearer-token interceptor mirroring
+// auth.Authenticate, plus role checks mirroring api/v1's RequireRole and
+// authorizeSelfOrAdmin, since grpc-gateway reverse-proxies the same
+// RBAC-protected paths onto this server.
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"usersvc/auth"
+)
+
+// AuthInterceptor validates the bearer token carried in the RPC's
+// "authorization" metadata and stashes its Claims in ctx for handlers and
+// RequireAdmin/requireSelfOrAdmin to read.
+func AuthInterceptor(issuer *auth.Issuer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		claims, err := authenticate(ctx, issuer)
+		if err != nil {
+			return nil, err
+		}
+		return handler(auth.ContextWithClaims(ctx, claims), req)
+	}
+}
+
+// StreamAuthInterceptor is AuthInterceptor for server-streaming RPCs
+// (StreamListUsers).
+func StreamAuthInterceptor(issuer *auth.Issuer) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		claims, err := authenticate(ss.Context(), issuer)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &claimsServerStream{
+			ServerStream: ss,
+			ctx:          auth.ContextWithClaims(ss.Context(), claims),
+		})
+	}
+}
+
+// claimsServerStream overrides Context so handlers reading it via
+// ss.Context() see the claims StreamAuthInterceptor stashed.
+type claimsServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *claimsServerStream) Context() context.Context { return s.ctx }
+
+func authenticate(ctx context.Context, issuer *auth.Issuer) (*auth.Claims, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok || token == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	claims, err := issuer.Parse(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
+	}
+	return claims, nil
+}
+
+func hasRole(claims *auth.Claims, role string) bool {
+	for _, r := range claims.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// RequireAdmin enforces the admin role for RPCs that REST gates behind
+// auth.RequireRole("admin") (ListUsers, StreamListUsers, DeleteUser).
+func RequireAdmin(ctx context.Context) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing authentication")
+	}
+	if !hasRole(claims, "admin") {
+		return status.Error(codes.PermissionDenied, "insufficient role")
+	}
+	return nil
+}
+
+// requireSelfOrAdmin mirrors api/v1's authorizeSelfOrAdmin: admins may act
+// on any record, everyone else only their own.
+func requireSelfOrAdmin(ctx context.Context, id int) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing authentication")
+	}
+	if claims.UserID == id || hasRole(claims, "admin") {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "insufficient role")
+}
+
+// isAdmin reports whether ctx's claims include the admin role; CreateUser
+// uses it to decide whether client-supplied roles may pass through, the
+// same way api/v1's CreateUser handler does.
+func isAdmin(ctx context.Context) bool {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	return ok && hasRole(claims, "admin")
+}
@@ -0,0 +1,40 @@
+// grpc-gateway wiring: lets REST clients hit /api/v1/users and have it
+// reverse-proxied into the same UserService implementation gRPC clients use,
+// so api/v1 and this package never drift from each other.
+package grpcapi
+
+import (
+	"context"
+	"net/http"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"usersvc/grpc/proto"
+)
+
+// NewGatewayHandler dials grpcAddr and returns an http.Handler that
+// reverse-proxies REST requests into the gRPC server listening there.
+func NewGatewayHandler(ctx context.Context, grpcAddr string) (http.Handler, error) {
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(forwardAuthorizationHeader))
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+	if err := proto.RegisterUserServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
+
+// forwardAuthorizationHeader forwards the REST Authorization header into the
+// gRPC call's "authorization" metadata key, which is what AuthInterceptor
+// reads. grpc-gateway's default matcher would otherwise rename it to
+// "grpcgateway-authorization", leaving AuthInterceptor unable to find it and
+// rejecting every request that comes in through the gateway.
+func forwardAuthorizationHeader(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == "Authorization" {
+		return "authorization", true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,49 @@
+// Interceptors mirroring middleware.Logging: request logging, panic
+// recovery, and deadline propagation, but for the gRPC transport.
+package grpcapi
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LoggingInterceptor logs every unary RPC the same way middleware.Logging
+// logs HTTP requests.
+func LoggingInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	log.Printf("%s %v %v", info.FullMethod, time.Since(start), err)
+	return resp, err
+}
+
+// RecoveryInterceptor converts a panicking handler into a codes.Internal
+// error instead of crashing the process.
+func RecoveryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("%s panicked: %v", info.FullMethod, r)
+			err = status.Errorf(codes.Internal, "internal error")
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// DefaultRPCDeadline bounds how long a unary RPC may run when the client
+// sends no deadline of its own.
+const DefaultRPCDeadline = 30 * time.Second
+
+// DeadlineInterceptor propagates the client's deadline if set, otherwise
+// applies DefaultRPCDeadline so a stuck handler can't run forever.
+func DeadlineInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultRPCDeadline)
+		defer cancel()
+	}
+	return handler(ctx, req)
+}
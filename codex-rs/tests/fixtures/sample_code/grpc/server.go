@@ -0,0 +1,157 @@
+// Package grpcapi wraps service.UserService with a gRPC server, served on
+// its own port alongside the REST API defined in api/v1.
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"usersvc/grpc/proto"
+	"usersvc/service"
+)
+
+// Server implements proto.UserServiceServer on top of service.UserService.
+type Server struct {
+	proto.UnimplementedUserServiceServer
+	service service.UserService
+}
+
+// NewServer creates a new gRPC server wrapping svc.
+func NewServer(svc service.UserService) *Server {
+	return &Server{service: svc}
+}
+
+func toProto(u *service.User) *proto.User {
+	return &proto.User{
+		Id:      int32(u.ID),
+		Name:    u.Name,
+		Email:   u.Email,
+		Active:  u.Active,
+		Roles:   u.Roles,
+		Created: u.Created.Format(timeFormat),
+		Updated: u.Updated.Format(timeFormat),
+	}
+}
+
+const timeFormat = "2006-01-02T15:04:05Z07:00"
+
+// CreateUser only lets client-supplied roles through for an admin caller,
+// same as api/v1's CreateUser handler: anyone else gets CreateUser's
+// default ("user") regardless of what the request asked for.
+func (s *Server) CreateUser(ctx context.Context, req *proto.CreateUserRequest) (*proto.User, error) {
+	user := &service.User{Name: req.Name, Email: req.Email}
+	if isAdmin(ctx) {
+		user.Roles = req.Roles
+	}
+	if err := s.service.CreateUser(ctx, user); err != nil {
+		return nil, status.Errorf(codes.Internal, "create user: %v", err)
+	}
+	return toProto(user), nil
+}
+
+func (s *Server) GetUser(ctx context.Context, req *proto.GetUserRequest) (*proto.User, error) {
+	if err := requireSelfOrAdmin(ctx, int(req.Id)); err != nil {
+		return nil, err
+	}
+
+	user, err := s.service.GetUser(ctx, int(req.Id))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "get user: %v", err)
+	}
+	return toProto(user), nil
+}
+
+// UpdateUser preserves PasswordHash and Roles from the stored record: the
+// request has no notion of either (proto.UpdateUserRequest carries neither
+// field), and Store.Save overwrites every column, so building the update
+// from the request alone would silently erase the user's password hash and
+// roles. This mirrors api/v1's UpdateUser handler.
+func (s *Server) UpdateUser(ctx context.Context, req *proto.UpdateUserRequest) (*proto.User, error) {
+	if err := requireSelfOrAdmin(ctx, int(req.Id)); err != nil {
+		return nil, err
+	}
+
+	current, err := s.service.GetUser(ctx, int(req.Id))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "update user: %v", err)
+	}
+
+	user := &service.User{
+		ID:           int(req.Id),
+		Name:         req.Name,
+		Email:        req.Email,
+		Active:       req.Active,
+		Roles:        current.Roles,
+		PasswordHash: current.PasswordHash,
+	}
+	if err := s.service.UpdateUser(ctx, user); err != nil {
+		return nil, status.Errorf(codes.Internal, "update user: %v", err)
+	}
+	return toProto(user), nil
+}
+
+func (s *Server) DeleteUser(ctx context.Context, req *proto.DeleteUserRequest) (*emptypb.Empty, error) {
+	if err := RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.DeleteUser(ctx, int(req.Id)); err != nil {
+		return nil, status.Errorf(codes.NotFound, "delete user: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListUsers(ctx context.Context, req *proto.ListUsersRequest) (*proto.ListUsersResponse, error) {
+	if err := RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	users, err := s.service.ListUsers(ctx, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list users: %v", err)
+	}
+
+	resp := &proto.ListUsersResponse{Users: make([]*proto.User, 0, len(users))}
+	for _, u := range users {
+		resp.Users = append(resp.Users, toProto(u))
+	}
+	return resp, nil
+}
+
+// StreamListUsers pages through ListUsers, sending one User at a time and
+// stopping as soon as the client cancels or the page comes back short.
+func (s *Server) StreamListUsers(req *proto.ListUsersRequest, stream proto.UserService_StreamListUsersServer) error {
+	if err := RequireAdmin(stream.Context()); err != nil {
+		return err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+	offset := int(req.Offset)
+
+	for {
+		users, err := s.service.ListUsers(stream.Context(), limit, offset)
+		if err != nil {
+			return status.Errorf(codes.Internal, "list users: %v", err)
+		}
+		for _, u := range users {
+			select {
+			case <-stream.Context().Done():
+				return status.FromContextError(stream.Context().Err()).Err()
+			default:
+			}
+			if err := stream.Send(toProto(u)); err != nil {
+				return err
+			}
+		}
+		if len(users) < limit {
+			return nil
+		}
+		offset += limit
+	}
+}
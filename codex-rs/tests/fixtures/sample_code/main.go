@@ -0,0 +1,204 @@
+// Sample Go code for testing AST parsing and compression.
+//
+// This is the composition root: it wires service.UserService to the api/v1
+// REST transport and the ui browsable front end, both served by one router.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+
+	v1 "usersvc/api/v1"
+	"usersvc/auth"
+	"usersvc/config"
+	grpcapi "usersvc/grpc"
+	"usersvc/grpc/proto"
+	"usersvc/middleware"
+	"usersvc/service"
+	"usersvc/ui"
+)
+
+// grpcAddr is where the gRPC server listens; the REST server listens on
+// Settings.ListenAddr (:8080 by default).
+// gatewayAddr carries the grpc-gateway's REST reverse proxy onto the same
+// gRPC implementation, for clients that want JSON/HTTP without going
+// through api/v1's handwritten handlers.
+const (
+	grpcAddr    = ":9090"
+	gatewayAddr = ":8081"
+)
+
+// serveGRPC starts the gRPC server wrapping svc. Unary RPCs get the same
+// logging behavior as middleware.Logging plus panic recovery and a default
+// deadline; streaming RPCs get only the auth interceptor.
+// AuthInterceptor/StreamAuthInterceptor enforce the same JWT + admin-role
+// RBAC as the REST API, since grpc-gateway reverse-proxies the same paths
+// onto this server.
+func serveGRPC(svc service.UserService, issuer *auth.Issuer) {
+	lis, err := net.Listen("tcp", grpcAddr)
+	if err != nil {
+		log.Fatal("Failed to listen for gRPC:", err)
+	}
+
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			grpcapi.RecoveryInterceptor,
+			grpcapi.LoggingInterceptor,
+			grpcapi.DeadlineInterceptor,
+			grpcapi.AuthInterceptor(issuer),
+		),
+		grpc.ChainStreamInterceptor(grpcapi.StreamAuthInterceptor(issuer)),
+	)
+	proto.RegisterUserServiceServer(srv, grpcapi.NewServer(svc))
+
+	log.Println("gRPC server starting on", grpcAddr)
+	if err := srv.Serve(lis); err != nil {
+		log.Fatal("gRPC server failed to start:", err)
+	}
+}
+
+// serveGateway starts a second HTTP listener running the grpc-gateway's
+// reverse proxy, dialed back into the gRPC server on grpcAddr. It's kept on
+// its own port rather than mounted into the api/v1 router so it doesn't
+// fight that router's routes for the same paths.
+func serveGateway(ctx context.Context) {
+	handler, err := grpcapi.NewGatewayHandler(ctx, grpcAddr)
+	if err != nil {
+		log.Fatal("Failed to initialize grpc-gateway:", err)
+	}
+
+	log.Println("grpc-gateway starting on", gatewayAddr)
+	if err := http.ListenAndServe(gatewayAddr, handler); err != nil && err != http.ErrServerClosed {
+		log.Fatal("grpc-gateway failed to start:", err)
+	}
+}
+
+// configHandlerFromEnv seeds a config.ConfigHandler from config.Default(),
+// overridden by CONFIG_FILE (JSON or YAML, by extension) if set.
+func configHandlerFromEnv() (config.ConfigHandler, error) {
+	cfg := config.New(config.Default())
+
+	path := os.Getenv("CONFIG_FILE")
+	if path == "" {
+		return cfg, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config file %s: %w", path, err)
+	}
+	if strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml") {
+		err = cfg.UnmarshalYAML(data)
+	} else {
+		err = cfg.Unmarshal(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load config file %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// authConfigFromSettings builds the JWT issuer config from the current
+// settings. HS256 is the default algorithm; it signs with AuthHMACSecret,
+// which config.Default() leaves empty, so the secret has to come from
+// CONFIG_FILE or NewIssuer rejects the config. No RSA keys are loaded here,
+// so NewIssuer rejects an RS256 setting as well.
+func authConfigFromSettings(settings config.Settings) auth.Config {
+	alg := auth.Algorithm(settings.AuthAlgorithm)
+	if alg == "" {
+		alg = auth.HS256
+	}
+	return auth.Config{
+		Algorithm:  alg,
+		HMACSecret: []byte(settings.AuthHMACSecret),
+		Issuer:     "usersvc",
+	}
+}
+
+// setupRoutes assembles the REST API, the UI, and the /metrics endpoint onto
+// a single router.
+func setupRoutes(apiHandler *v1.UserHandler, authHandler *v1.AuthHandler, configHandler *v1.ConfigHandler, issuer *auth.Issuer, uiHandler *ui.Handler) *mux.Router {
+	r := mux.NewRouter()
+	v1.Routes(r, apiHandler, authHandler, configHandler, issuer)
+	ui.Routes(r, uiHandler, issuer)
+	r.Handle("/metrics", middleware.Handler()).Methods("GET")
+	return r
+}
+
+func main() {
+	store, err := service.NewStore(service.StoreConfigFromEnv())
+	if err != nil {
+		log.Fatal("Failed to initialize store:", err)
+	}
+	defer store.Close()
+
+	svc := service.NewUserService(store)
+
+	// Bootstrap the first admin from ADMIN_NAME/ADMIN_EMAIL/ADMIN_PASSWORD,
+	// if set and no admin exists yet. Without this, a fresh deployment has
+	// no way to reach ListUsers, DeleteUser, /config, or /ui: every path
+	// that creates a user forces the "user" role unless the caller is
+	// already an admin. See service.SeedAdmin.
+	if err := service.SeedAdmin(context.Background(), svc, service.SeedAdminConfigFromEnv()); err != nil {
+		log.Fatal("Failed to seed initial admin:", err)
+	}
+
+	cfg, err := configHandlerFromEnv()
+	if err != nil {
+		log.Fatal("Failed to initialize config:", err)
+	}
+	settings := cfg.Settings()
+
+	issuer, err := auth.NewIssuer(authConfigFromSettings(settings))
+	if err != nil {
+		log.Fatal("Failed to initialize auth issuer:", err)
+	}
+
+	apiHandler := v1.NewUserHandler(svc, cfg)
+	authHandler := v1.NewAuthHandler(svc, issuer)
+	configHandler := v1.NewConfigHandler(cfg)
+	uiHandler, err := ui.NewHandler(svc)
+	if err != nil {
+		log.Fatal("Failed to initialize UI handler:", err)
+	}
+
+	router := setupRoutes(apiHandler, authHandler, configHandler, issuer, uiHandler)
+
+	// RequestID first (outermost) so every later middleware (and the
+	// handlers themselves) can see the ID; Logging last, so it runs
+	// innermost and its duration covers the handler alone.
+	router.Use(middleware.RequestID, middleware.Tracing, middleware.Metrics, middleware.Logging)
+
+	go serveGRPC(svc, issuer)
+	go serveGateway(context.Background())
+
+	srv := &http.Server{
+		Addr:         settings.ListenAddr,
+		Handler:      router,
+		ReadTimeout:  settings.ReadTimeout,
+		WriteTimeout: settings.WriteTimeout,
+		IdleTimeout:  settings.IdleTimeout,
+	}
+
+	// Re-read timeouts on every config change instead of requiring a
+	// restart. ListenAddr isn't included: changing the bind address does
+	// need a fresh listener, which is out of scope here.
+	cfg.OnChange(func(s config.Settings) {
+		srv.ReadTimeout = s.ReadTimeout
+		srv.WriteTimeout = s.WriteTimeout
+		srv.IdleTimeout = s.IdleTimeout
+		log.Printf("config changed, timeouts reloaded (fingerprint %s)", cfg.Fingerprint())
+	})
+
+	log.Println("Server starting on", settings.ListenAddr)
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		log.Fatal("Server failed to start:", err)
+	}
+}
@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+	"time"
+)
+
+// Logging logs every request's method, path, request ID, and duration. It
+// runs innermost in the chain, so the duration it reports covers the
+// handler alone, not the Metrics and Tracing middleware around it.
+func Logging(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		next.ServeHTTP(w, r)
+		log.Printf("[%s] %s %s %v", RequestIDFromContext(r.Context()), r.Method, r.URL.Path, time.Since(start))
+	})
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Total HTTP requests, by method, route, and status.",
+	}, []string{"method", "route", "status"})
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request duration in seconds, by method and route.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+)
+
+func init() {
+	prometheus.MustRegister(requestsTotal, requestDuration)
+}
+
+// Metrics records http_requests_total and http_request_duration_seconds for
+// every request. Routes are labeled with the route's path template rather
+// than the raw URL path, so IDs in the URL (e.g. /users/42) don't blow up
+// label cardinality.
+func Metrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(rec, r)
+
+		route := routeTemplate(r)
+		requestsTotal.WithLabelValues(r.Method, route, strconv.Itoa(rec.status)).Inc()
+		requestDuration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+	})
+}
+
+// routeTemplate returns the matched route's pattern (e.g.
+// "/api/v1/users/{id:[0-9]+}"), or "unmatched" if nothing matched.
+func routeTemplate(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if tpl, err := route.GetPathTemplate(); err == nil {
+			return tpl
+		}
+	}
+	return "unmatched"
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Flush forwards to the underlying ResponseWriter's Flusher, if it has one,
+// so Metrics doesn't break handlers like StreamListUsers that type-assert
+// http.Flusher off the writer they're given.
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap exposes the wrapped ResponseWriter so other type assertions
+// (http.Hijacker, http.Pusher, ...) made via errors.As-style unwrapping can
+// still reach it through this wrapper.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// Handler serves the registered metrics for GET /metrics.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
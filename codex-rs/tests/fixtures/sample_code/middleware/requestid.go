@@ -0,0 +1,38 @@
+// Package middleware is the HTTP middleware chain mounted on every route:
+// RequestID, Tracing, Metrics, and Logging, applied in that order so each
+// later middleware can see what the earlier ones stamped into the request.
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+type contextKey string
+
+const requestIDKey contextKey = "request_id"
+
+// RequestID stamps X-Request-ID onto the request context and the response,
+// reusing the caller's header value if they sent one so traces survive a
+// hop through a proxy that already assigned an ID.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Request-ID")
+		if id == "" {
+			id = uuid.NewString()
+		}
+		w.Header().Set("X-Request-ID", id)
+
+		ctx := context.WithValue(r.Context(), requestIDKey, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// RequestIDFromContext returns the request ID stamped by RequestID, or ""
+// if it hasn't run.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
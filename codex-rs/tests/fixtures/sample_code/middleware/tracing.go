@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var tracer = otel.Tracer("usersvc")
+
+// Tracing starts a span for every request, named after the route's path
+// template. The span lives in the request context, so it propagates into
+// UserService methods (and whatever child spans they start) for free.
+func Tracing(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := routeTemplate(r)
+
+		ctx, span := tracer.Start(r.Context(), route)
+		defer span.End()
+
+		span.SetAttributes(
+			attribute.String("http.method", r.Method),
+			attribute.String("http.route", route),
+			attribute.String("http.request_id", RequestIDFromContext(ctx)),
+		)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
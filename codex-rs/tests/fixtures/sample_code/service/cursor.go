@@ -0,0 +1,32 @@
+// Cursor encoding for ListUsersPage. The cursor is opaque to callers; under
+// the hood it's just the last row ID seen on the previous page.
+
+package service
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+)
+
+// EncodeCursor opaquely encodes id as a page cursor.
+func EncodeCursor(id int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(id)))
+}
+
+// DecodeCursor reverses EncodeCursor. An empty cursor decodes to 0, meaning
+// "start from the beginning".
+func DecodeCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cursor: %w", err)
+	}
+	id, err := strconv.Atoi(string(data))
+	if err != nil {
+		return 0, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return id, nil
+}
@@ -0,0 +1,32 @@
+package service
+
+import "testing"
+
+func TestCursorRoundTrip(t *testing.T) {
+	for _, id := range []int{0, 1, 42, 1000000} {
+		cursor := EncodeCursor(id)
+		got, err := DecodeCursor(cursor)
+		if err != nil {
+			t.Fatalf("DecodeCursor(%q): %v", cursor, err)
+		}
+		if got != id {
+			t.Fatalf("DecodeCursor(EncodeCursor(%d)) = %d", id, got)
+		}
+	}
+}
+
+func TestDecodeCursorEmptyMeansStart(t *testing.T) {
+	id, err := DecodeCursor("")
+	if err != nil {
+		t.Fatalf(`DecodeCursor(""): %v`, err)
+	}
+	if id != 0 {
+		t.Fatalf(`DecodeCursor("") = %d, want 0`, id)
+	}
+}
+
+func TestDecodeCursorRejectsGarbage(t *testing.T) {
+	if _, err := DecodeCursor("not valid base64!!"); err == nil {
+		t.Fatal("DecodeCursor accepted invalid input")
+	}
+}
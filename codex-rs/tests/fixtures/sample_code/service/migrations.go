@@ -0,0 +1,30 @@
+// Schema migrations for the SQL store driver.
+
+package service
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const usersTableSchema = `
+CREATE TABLE IF NOT EXISTS users (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	name          TEXT NOT NULL,
+	email         TEXT NOT NULL UNIQUE,
+	active        BOOLEAN NOT NULL DEFAULT TRUE,
+	roles         TEXT NOT NULL DEFAULT '[]',
+	password_hash TEXT NOT NULL DEFAULT '',
+	created_at    TIMESTAMP NOT NULL,
+	updated_at    TIMESTAMP NOT NULL
+);
+`
+
+// Migrate brings db up to the schema the SQL store expects. It is safe to
+// call on every startup.
+func Migrate(db *sql.DB) error {
+	if _, err := db.Exec(usersTableSchema); err != nil {
+		return fmt.Errorf("migrate: create users table: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"os"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// SeedAdminConfig configures SeedAdmin's bootstrap admin account.
+type SeedAdminConfig struct {
+	Name     string
+	Email    string
+	Password string
+}
+
+// SeedAdminConfigFromEnv builds a SeedAdminConfig from ADMIN_NAME/
+// ADMIN_EMAIL/ADMIN_PASSWORD. Email or Password empty means "don't seed".
+func SeedAdminConfigFromEnv() SeedAdminConfig {
+	return SeedAdminConfig{
+		Name:     os.Getenv("ADMIN_NAME"),
+		Email:    os.Getenv("ADMIN_EMAIL"),
+		Password: os.Getenv("ADMIN_PASSWORD"),
+	}
+}
+
+// SeedAdmin creates the first admin user from cfg, unless one already
+// exists. Without this there is no way to reach any admin-only route
+// (ListUsers, DeleteUser, GET/PATCH /config, /ui) on a fresh deployment:
+// Register and CreateUser both force the "user" role for anyone who isn't
+// already an admin, so the very first account could never become one on
+// its own.
+func SeedAdmin(ctx context.Context, svc UserService, cfg SeedAdminConfig) error {
+	if cfg.Email == "" || cfg.Password == "" {
+		return nil
+	}
+
+	exists, err := hasAdmin(ctx, svc)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(cfg.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	return svc.CreateUser(ctx, &User{
+		Name:         cfg.Name,
+		Email:        cfg.Email,
+		PasswordHash: string(hash),
+		Roles:        []string{"admin"},
+	})
+}
+
+// hasAdmin scans pages of users looking for one with the admin role. The
+// sample store sizes make this acceptable; see AuthHandler.findByEmail in
+// api/v1 for the same tradeoff.
+func hasAdmin(ctx context.Context, svc UserService) (bool, error) {
+	const pageSize = 100
+	for offset := 0; ; offset += pageSize {
+		users, err := svc.ListUsers(ctx, pageSize, offset)
+		if err != nil {
+			return false, err
+		}
+		for _, u := range users {
+			for _, role := range u.Roles {
+				if role == "admin" {
+					return true, nil
+				}
+			}
+		}
+		if len(users) < pageSize {
+			return false, nil
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Store abstractions backing UserService. A Store is responsible purely for
+// persistence; business rules (timestamps, defaults, validation) stay in
+// userService so every driver behaves identically from the caller's view.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+)
+
+// Store is implemented by every persistence driver UserService can use.
+// Insert is responsible for assigning user.ID. Every iteration over more
+// than one record (FindAll, FindPage) must check ctx between records so a
+// canceled or timed-out request stops promptly instead of paging to completion.
+type Store interface {
+	Insert(ctx context.Context, user *User) error
+	Find(ctx context.Context, id int) (*User, error)
+	Save(ctx context.Context, user *User) error
+	Remove(ctx context.Context, id int) error
+	FindAll(ctx context.Context, limit, offset int) ([]*User, error)
+
+	// FindPage returns up to limit users with ID > afterID, ordered by ID.
+	FindPage(ctx context.Context, afterID, limit int) ([]*User, error)
+
+	Close() error
+}
+
+// StoreConfig selects and configures a Store driver.
+type StoreConfig struct {
+	Driver string // "memory", "badger", "sql", or "redis"
+	DSN    string // driver-specific connection string / path
+}
+
+// StoreConfigFromEnv builds a StoreConfig from STORE_DRIVER/STORE_DSN,
+// defaulting to the in-memory driver when unset.
+func StoreConfigFromEnv() StoreConfig {
+	cfg := StoreConfig{Driver: os.Getenv("STORE_DRIVER"), DSN: os.Getenv("STORE_DSN")}
+	if cfg.Driver == "" {
+		cfg.Driver = "memory"
+	}
+	return cfg
+}
+
+// NewStore constructs the Store driver named by cfg.Driver.
+func NewStore(cfg StoreConfig) (Store, error) {
+	switch cfg.Driver {
+	case "memory", "":
+		return newMemoryStore(), nil
+	case "badger":
+		return newBadgerStore(cfg.DSN)
+	case "sql":
+		return newSQLStore(cfg.DSN)
+	case "redis":
+		return newRedisStore(cfg.DSN)
+	default:
+		return nil, fmt.Errorf("store: unknown driver %q", cfg.Driver)
+	}
+}
+
+// memoryStore is the original map-backed implementation, kept as the default
+// driver and as a reference implementation for the others.
+type memoryStore struct {
+	mu     sync.RWMutex
+	users  map[int]*User
+	nextID int
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{users: make(map[int]*User), nextID: 1}
+}
+
+func (s *memoryStore) Insert(ctx context.Context, user *User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user.ID = s.nextID
+	s.nextID++
+	s.users[user.ID] = user
+	return nil
+}
+
+func (s *memoryStore) Find(ctx context.Context, id int) (*User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, exists := s.users[id]
+	if !exists {
+		return nil, fmt.Errorf("user with id %d not found", id)
+	}
+	userCopy := *user
+	return &userCopy, nil
+}
+
+func (s *memoryStore) Save(ctx context.Context, user *User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, exists := s.users[user.ID]
+	if !exists {
+		return fmt.Errorf("user with id %d not found", user.ID)
+	}
+	existing.Name = user.Name
+	existing.Email = user.Email
+	existing.Active = user.Active
+	existing.Roles = user.Roles
+	existing.PasswordHash = user.PasswordHash
+	existing.Updated = user.Updated
+	return nil
+}
+
+func (s *memoryStore) Remove(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.users[id]; !exists {
+		return fmt.Errorf("user with id %d not found", id)
+	}
+	delete(s.users, id)
+	return nil
+}
+
+func (s *memoryStore) FindAll(ctx context.Context, limit, offset int) ([]*User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := s.sortedIDs()
+	users := make([]*User, 0, limit)
+	for i, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if i < offset {
+			continue
+		}
+		if len(users) >= limit {
+			break
+		}
+		userCopy := *s.users[id]
+		users = append(users, &userCopy)
+	}
+	return users, nil
+}
+
+func (s *memoryStore) FindPage(ctx context.Context, afterID, limit int) ([]*User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	users := make([]*User, 0, limit)
+	for _, id := range s.sortedIDs() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if id <= afterID {
+			continue
+		}
+		if len(users) >= limit {
+			break
+		}
+		userCopy := *s.users[id]
+		users = append(users, &userCopy)
+	}
+	return users, nil
+}
+
+func (s *memoryStore) sortedIDs() []int {
+	ids := make([]int, 0, len(s.users))
+	for id := range s.users {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func (s *memoryStore) Close() error { return nil }
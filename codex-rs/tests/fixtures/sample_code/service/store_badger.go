@@ -0,0 +1,205 @@
+// BadgerDB-backed Store: an embedded, single-process driver for deployments
+// that want durability without running a separate database server.
+
+package service
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+const badgerNextIDKey = "meta:next_id"
+
+type badgerStore struct {
+	db *badger.DB
+}
+
+func newBadgerStore(path string) (*badgerStore, error) {
+	if path == "" {
+		path = "./data/users.badger"
+	}
+	db, err := badger.Open(badger.DefaultOptions(path))
+	if err != nil {
+		return nil, fmt.Errorf("badger store: open %s: %w", path, err)
+	}
+	return &badgerStore{db: db}, nil
+}
+
+// userKey zero-pads id so lexicographic key order (what badger iterates in)
+// matches numeric ID order, which FindPage's afterID cursor relies on.
+func userKey(id int) []byte {
+	return []byte(fmt.Sprintf("user:%010d", id))
+}
+
+func (s *badgerStore) Insert(ctx context.Context, user *User) error {
+	return s.db.Update(func(txn *badger.Txn) error {
+		id, err := s.nextID(txn)
+		if err != nil {
+			return err
+		}
+		user.ID = id
+		return s.putUser(txn, user)
+	})
+}
+
+func (s *badgerStore) nextID(txn *badger.Txn) (int, error) {
+	item, err := txn.Get([]byte(badgerNextIDKey))
+	if err == badger.ErrKeyNotFound {
+		if err := txn.Set([]byte(badgerNextIDKey), encodeID(2)); err != nil {
+			return 0, err
+		}
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var next int
+	if err := item.Value(func(val []byte) error {
+		next = decodeID(val)
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	if err := txn.Set([]byte(badgerNextIDKey), encodeID(next+1)); err != nil {
+		return 0, err
+	}
+	return next, nil
+}
+
+func encodeID(id int) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(id))
+	return buf
+}
+
+func decodeID(buf []byte) int {
+	return int(binary.BigEndian.Uint64(buf))
+}
+
+func (s *badgerStore) putUser(txn *badger.Txn, user *User) error {
+	data, err := json.Marshal(toStoredUser(user))
+	if err != nil {
+		return fmt.Errorf("badger store: marshal user %d: %w", user.ID, err)
+	}
+	return txn.Set(userKey(user.ID), data)
+}
+
+func (s *badgerStore) Find(ctx context.Context, id int) (*User, error) {
+	var stored storedUser
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(userKey(id))
+		if err == badger.ErrKeyNotFound {
+			return fmt.Errorf("user with id %d not found", id)
+		}
+		if err != nil {
+			return err
+		}
+		return item.Value(func(val []byte) error {
+			return json.Unmarshal(val, &stored)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stored.toUser(), nil
+}
+
+func (s *badgerStore) Save(ctx context.Context, user *User) error {
+	return s.db.Update(func(txn *badger.Txn) error {
+		if _, err := txn.Get(userKey(user.ID)); err != nil {
+			if err == badger.ErrKeyNotFound {
+				return fmt.Errorf("user with id %d not found", user.ID)
+			}
+			return err
+		}
+		return s.putUser(txn, user)
+	})
+}
+
+func (s *badgerStore) Remove(ctx context.Context, id int) error {
+	return s.db.Update(func(txn *badger.Txn) error {
+		if _, err := txn.Get(userKey(id)); err != nil {
+			if err == badger.ErrKeyNotFound {
+				return fmt.Errorf("user with id %d not found", id)
+			}
+			return err
+		}
+		return txn.Delete(userKey(id))
+	})
+}
+
+func (s *badgerStore) FindAll(ctx context.Context, limit, offset int) ([]*User, error) {
+	var users []*User
+	err := s.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte("user:")
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		skipped := 0
+		for it.Rewind(); it.Valid(); it.Next() {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if skipped < offset {
+				skipped++
+				continue
+			}
+			if len(users) >= limit {
+				break
+			}
+			var stored storedUser
+			if err := it.Item().Value(func(val []byte) error {
+				return json.Unmarshal(val, &stored)
+			}); err != nil {
+				return err
+			}
+			users = append(users, stored.toUser())
+		}
+		return nil
+	})
+	if users == nil {
+		users = []*User{}
+	}
+	return users, err
+}
+
+func (s *badgerStore) FindPage(ctx context.Context, afterID, limit int) ([]*User, error) {
+	var users []*User
+	err := s.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte("user:")
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(userKey(afterID + 1)); it.Valid(); it.Next() {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if len(users) >= limit {
+				break
+			}
+			var stored storedUser
+			if err := it.Item().Value(func(val []byte) error {
+				return json.Unmarshal(val, &stored)
+			}); err != nil {
+				return err
+			}
+			users = append(users, stored.toUser())
+		}
+		return nil
+	})
+	if users == nil {
+		users = []*User{}
+	}
+	return users, err
+}
+
+func (s *badgerStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,14 @@
+package service
+
+import "testing"
+
+func TestBadgerStore(t *testing.T) {
+	runConformanceTests(t, func(t *testing.T) Store {
+		store, err := newBadgerStore(t.TempDir())
+		if err != nil {
+			t.Fatalf("newBadgerStore: %v", err)
+		}
+		t.Cleanup(func() { store.Close() })
+		return store
+	})
+}
@@ -0,0 +1,151 @@
+// Shared conformance suite for the Store interface. Every driver test calls
+// runConformanceTests with its own constructor so a new driver starts from
+// the same baseline the others are held to, instead of re-deriving these
+// assertions per file.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func runConformanceTests(t *testing.T, newStore func(t *testing.T) Store) {
+	t.Run("InsertAssignsIDAndRoundTripsFields", func(t *testing.T) {
+		store := newStore(t)
+		user := &User{
+			Name:         "Ada",
+			Email:        "ada@example.com",
+			Active:       true,
+			Roles:        []string{"user"},
+			PasswordHash: "hash-1",
+		}
+		if err := store.Insert(context.Background(), user); err != nil {
+			t.Fatalf("Insert: %v", err)
+		}
+		if user.ID == 0 {
+			t.Fatal("Insert did not assign an ID")
+		}
+
+		got, err := store.Find(context.Background(), user.ID)
+		if err != nil {
+			t.Fatalf("Find: %v", err)
+		}
+		if got.Email != user.Email || got.PasswordHash != user.PasswordHash {
+			t.Fatalf("Find = %+v, want email/password_hash matching %+v", got, user)
+		}
+		if len(got.Roles) != 1 || got.Roles[0] != "user" {
+			t.Fatalf("Find did not round-trip roles, got %v", got.Roles)
+		}
+	})
+
+	t.Run("SaveUpdatesFields", func(t *testing.T) {
+		store := newStore(t)
+		user := &User{Name: "Ada", Email: "ada@example.com"}
+		if err := store.Insert(context.Background(), user); err != nil {
+			t.Fatalf("Insert: %v", err)
+		}
+
+		user.Name = "Ada Lovelace"
+		user.Roles = []string{"admin"}
+		user.PasswordHash = "hash-2"
+		if err := store.Save(context.Background(), user); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+
+		got, err := store.Find(context.Background(), user.ID)
+		if err != nil {
+			t.Fatalf("Find: %v", err)
+		}
+		if got.Name != "Ada Lovelace" || got.PasswordHash != "hash-2" {
+			t.Fatalf("Save did not persist name/password_hash, got %+v", got)
+		}
+		if len(got.Roles) != 1 || got.Roles[0] != "admin" {
+			t.Fatalf("Save did not persist roles, got %v", got.Roles)
+		}
+	})
+
+	t.Run("SaveUnknownIDFails", func(t *testing.T) {
+		store := newStore(t)
+		if err := store.Save(context.Background(), &User{ID: 999999, Name: "ghost"}); err == nil {
+			t.Fatal("Save succeeded for a nonexistent ID")
+		}
+	})
+
+	t.Run("RemoveDeletesUser", func(t *testing.T) {
+		store := newStore(t)
+		user := &User{Name: "Grace", Email: "grace@example.com"}
+		if err := store.Insert(context.Background(), user); err != nil {
+			t.Fatalf("Insert: %v", err)
+		}
+		if err := store.Remove(context.Background(), user.ID); err != nil {
+			t.Fatalf("Remove: %v", err)
+		}
+		if _, err := store.Find(context.Background(), user.ID); err == nil {
+			t.Fatal("Find succeeded after Remove")
+		}
+	})
+
+	t.Run("FindAllRespectsLimitAndOffset", func(t *testing.T) {
+		store := newStore(t)
+		for i := 0; i < 5; i++ {
+			user := &User{Name: fmt.Sprintf("user-%d", i), Email: fmt.Sprintf("findall-%d@example.com", i)}
+			if err := store.Insert(context.Background(), user); err != nil {
+				t.Fatalf("Insert: %v", err)
+			}
+		}
+
+		users, err := store.FindAll(context.Background(), 2, 1)
+		if err != nil {
+			t.Fatalf("FindAll: %v", err)
+		}
+		if len(users) != 2 {
+			t.Fatalf("FindAll returned %d users, want 2", len(users))
+		}
+	})
+
+	t.Run("FindPageCursorsForward", func(t *testing.T) {
+		store := newStore(t)
+		var ids []int
+		for i := 0; i < 3; i++ {
+			user := &User{Name: fmt.Sprintf("page-%d", i), Email: fmt.Sprintf("findpage-%d@example.com", i)}
+			if err := store.Insert(context.Background(), user); err != nil {
+				t.Fatalf("Insert: %v", err)
+			}
+			ids = append(ids, user.ID)
+		}
+
+		first, err := store.FindPage(context.Background(), 0, 2)
+		if err != nil {
+			t.Fatalf("FindPage: %v", err)
+		}
+		if len(first) != 2 || first[0].ID != ids[0] || first[1].ID != ids[1] {
+			t.Fatalf("FindPage first page = %+v, want ids %v", first, ids[:2])
+		}
+
+		second, err := store.FindPage(context.Background(), first[len(first)-1].ID, 2)
+		if err != nil {
+			t.Fatalf("FindPage: %v", err)
+		}
+		if len(second) != 1 || second[0].ID != ids[2] {
+			t.Fatalf("FindPage second page = %+v, want id %d", second, ids[2])
+		}
+	})
+
+	t.Run("FindPageStopsOnCanceledContext", func(t *testing.T) {
+		store := newStore(t)
+		if err := store.Insert(context.Background(), &User{Name: "x", Email: "cancel-1@example.com"}); err != nil {
+			t.Fatalf("Insert: %v", err)
+		}
+		if err := store.Insert(context.Background(), &User{Name: "y", Email: "cancel-2@example.com"}); err != nil {
+			t.Fatalf("Insert: %v", err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := store.FindPage(ctx, 0, 10); !errors.Is(err, context.Canceled) {
+			t.Fatalf("FindPage with a canceled context returned %v, want context.Canceled", err)
+		}
+	})
+}
@@ -0,0 +1,9 @@
+package service
+
+import "testing"
+
+func TestMemoryStore(t *testing.T) {
+	runConformanceTests(t, func(t *testing.T) Store {
+		return newMemoryStore()
+	})
+}
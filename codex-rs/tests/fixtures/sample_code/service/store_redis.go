@@ -0,0 +1,171 @@
+// Redis-backed Store. Users are stored as JSON blobs under "user:<id>" with
+// their ids tracked in a "users" set and a "users:next_id" counter so FindAll
+// can page without a full KEYS scan.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type redisStore struct {
+	client *redis.Client
+}
+
+func newRedisStore(addr string) (*redisStore, error) {
+	if addr == "" {
+		addr = "localhost:6379"
+	}
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		return nil, fmt.Errorf("redis store: ping %s: %w", addr, err)
+	}
+	return &redisStore{client: client}, nil
+}
+
+func (s *redisStore) Insert(ctx context.Context, user *User) error {
+	id, err := s.client.Incr(ctx, "users:next_id").Result()
+	if err != nil {
+		return fmt.Errorf("redis store: next id: %w", err)
+	}
+	user.ID = int(id)
+
+	data, err := json.Marshal(toStoredUser(user))
+	if err != nil {
+		return fmt.Errorf("redis store: marshal user: %w", err)
+	}
+
+	pipe := s.client.TxPipeline()
+	pipe.Set(ctx, fmt.Sprintf("user:%d", user.ID), data, 0)
+	pipe.SAdd(ctx, "users", user.ID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis store: insert user: %w", err)
+	}
+	return nil
+}
+
+func (s *redisStore) Find(ctx context.Context, id int) (*User, error) {
+	data, err := s.client.Get(ctx, fmt.Sprintf("user:%d", id)).Bytes()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("user with id %d not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis store: get user %d: %w", id, err)
+	}
+	var stored storedUser
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("redis store: unmarshal user %d: %w", id, err)
+	}
+	return stored.toUser(), nil
+}
+
+func (s *redisStore) Save(ctx context.Context, user *User) error {
+	key := fmt.Sprintf("user:%d", user.ID)
+	exists, err := s.client.Exists(ctx, key).Result()
+	if err != nil {
+		return fmt.Errorf("redis store: exists user %d: %w", user.ID, err)
+	}
+	if exists == 0 {
+		return fmt.Errorf("user with id %d not found", user.ID)
+	}
+	data, err := json.Marshal(toStoredUser(user))
+	if err != nil {
+		return fmt.Errorf("redis store: marshal user: %w", err)
+	}
+	return s.client.Set(ctx, key, data, 0).Err()
+}
+
+func (s *redisStore) Remove(ctx context.Context, id int) error {
+	key := fmt.Sprintf("user:%d", id)
+	pipe := s.client.TxPipeline()
+	del := pipe.Del(ctx, key)
+	pipe.SRem(ctx, "users", id)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis store: remove user %d: %w", id, err)
+	}
+	if del.Val() == 0 {
+		return fmt.Errorf("user with id %d not found", id)
+	}
+	return nil
+}
+
+func (s *redisStore) FindAll(ctx context.Context, limit, offset int) ([]*User, error) {
+	idStrs, err := s.client.SMembers(ctx, "users").Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis store: list ids: %w", err)
+	}
+
+	ids := make([]int, 0, len(idStrs))
+	for _, s := range idStrs {
+		id, err := strconv.Atoi(s)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	if offset > len(ids) {
+		return []*User{}, nil
+	}
+	end := offset + limit
+	if end > len(ids) {
+		end = len(ids)
+	}
+
+	users := make([]*User, 0, end-offset)
+	for _, id := range ids[offset:end] {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		user, err := s.Find(ctx, id)
+		if err != nil {
+			continue
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func (s *redisStore) FindPage(ctx context.Context, afterID, limit int) ([]*User, error) {
+	idStrs, err := s.client.SMembers(ctx, "users").Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis store: list ids: %w", err)
+	}
+
+	ids := make([]int, 0, len(idStrs))
+	for _, s := range idStrs {
+		id, err := strconv.Atoi(s)
+		if err != nil || id <= afterID {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	users := make([]*User, 0, len(ids))
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		user, err := s.Find(ctx, id)
+		if err != nil {
+			continue
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func (s *redisStore) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+	"testing"
+)
+
+// TestRedisStore exercises the conformance suite against a local redis
+// instance (default addr, same as newRedisStore("")) and skips if one isn't
+// reachable. It flushes that instance's current DB before every subtest, so
+// point it at a throwaway/dev redis, never a shared or production one.
+func TestRedisStore(t *testing.T) {
+	runConformanceTests(t, func(t *testing.T) Store {
+		store, err := newRedisStore("")
+		if err != nil {
+			t.Skipf("redis not available: %v", err)
+		}
+		rs := store.(*redisStore)
+		if err := rs.client.FlushDB(context.Background()).Err(); err != nil {
+			t.Fatalf("flush redis: %v", err)
+		}
+		t.Cleanup(func() { store.Close() })
+		return store
+	})
+}
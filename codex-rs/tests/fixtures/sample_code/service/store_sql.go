@@ -0,0 +1,197 @@
+// database/sql-backed Store. It always opens the DSN with the "sqlite3"
+// driver, which must be registered with database/sql, and its schema and
+// queries use sqlite syntax; the DSN does not select another engine.
+// Create/Update/Delete each run inside a transaction.
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type sqlStore struct {
+	db *sql.DB
+}
+
+// encodeRoles/decodeRoles store Roles as a JSON array in a single TEXT
+// column, since the sample schema targets sqlite and other engines without
+// a native array type.
+func encodeRoles(roles []string) (string, error) {
+	data, err := json.Marshal(roles)
+	if err != nil {
+		return "", fmt.Errorf("sql store: marshal roles: %w", err)
+	}
+	return string(data), nil
+}
+
+func decodeRoles(data string) ([]string, error) {
+	var roles []string
+	if err := json.Unmarshal([]byte(data), &roles); err != nil {
+		return nil, fmt.Errorf("sql store: unmarshal roles: %w", err)
+	}
+	return roles, nil
+}
+
+func newSQLStore(dsn string) (*sqlStore, error) {
+	if dsn == "" {
+		dsn = "sqlite3://./data/users.db"
+	}
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("sql store: open: %w", err)
+	}
+	if err := Migrate(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &sqlStore{db: db}, nil
+}
+
+func (s *sqlStore) Insert(ctx context.Context, user *User) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("sql store: begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	roles, err := encodeRoles(user.Roles)
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO users (name, email, active, roles, password_hash, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		user.Name, user.Email, user.Active, roles, user.PasswordHash, user.Created, user.Updated)
+	if err != nil {
+		return fmt.Errorf("sql store: insert user: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("sql store: last insert id: %w", err)
+	}
+	user.ID = int(id)
+	return tx.Commit()
+}
+
+func (s *sqlStore) Find(ctx context.Context, id int) (*User, error) {
+	var user User
+	var roles string
+	row := s.db.QueryRowContext(ctx,
+		`SELECT id, name, email, active, roles, password_hash, created_at, updated_at FROM users WHERE id = ?`, id)
+	if err := row.Scan(&user.ID, &user.Name, &user.Email, &user.Active, &roles, &user.PasswordHash, &user.Created, &user.Updated); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("user with id %d not found", id)
+		}
+		return nil, fmt.Errorf("sql store: find user %d: %w", id, err)
+	}
+	decoded, err := decodeRoles(roles)
+	if err != nil {
+		return nil, err
+	}
+	user.Roles = decoded
+	return &user, nil
+}
+
+func (s *sqlStore) Save(ctx context.Context, user *User) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("sql store: begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	roles, err := encodeRoles(user.Roles)
+	if err != nil {
+		return err
+	}
+
+	user.Updated = time.Now()
+	res, err := tx.ExecContext(ctx,
+		`UPDATE users SET name = ?, email = ?, active = ?, roles = ?, password_hash = ?, updated_at = ? WHERE id = ?`,
+		user.Name, user.Email, user.Active, roles, user.PasswordHash, user.Updated, user.ID)
+	if err != nil {
+		return fmt.Errorf("sql store: update user %d: %w", user.ID, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("user with id %d not found", user.ID)
+	}
+	return tx.Commit()
+}
+
+func (s *sqlStore) Remove(ctx context.Context, id int) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("sql store: begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM users WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("sql store: delete user %d: %w", id, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("user with id %d not found", id)
+	}
+	return tx.Commit()
+}
+
+func (s *sqlStore) FindAll(ctx context.Context, limit, offset int) ([]*User, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, name, email, active, roles, password_hash, created_at, updated_at FROM users ORDER BY id LIMIT ? OFFSET ?`,
+		limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("sql store: list users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]*User, 0, limit)
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var user User
+		var roles string
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Active, &roles, &user.PasswordHash, &user.Created, &user.Updated); err != nil {
+			return nil, fmt.Errorf("sql store: scan user: %w", err)
+		}
+		if user.Roles, err = decodeRoles(roles); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
+
+func (s *sqlStore) FindPage(ctx context.Context, afterID, limit int) ([]*User, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, name, email, active, roles, password_hash, created_at, updated_at FROM users WHERE id > ? ORDER BY id LIMIT ?`,
+		afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("sql store: list users page: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]*User, 0, limit)
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var user User
+		var roles string
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Active, &roles, &user.PasswordHash, &user.Created, &user.Updated); err != nil {
+			return nil, fmt.Errorf("sql store: scan user: %w", err)
+		}
+		if user.Roles, err = decodeRoles(roles); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
+
+func (s *sqlStore) Close() error {
+	return s.db.Close()
+}
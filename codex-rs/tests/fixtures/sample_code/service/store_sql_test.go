@@ -0,0 +1,20 @@
+package service
+
+import (
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestSQLStore(t *testing.T) {
+	runConformanceTests(t, func(t *testing.T) Store {
+		dsn := filepath.Join(t.TempDir(), "users.db")
+		store, err := newSQLStore(dsn)
+		if err != nil {
+			t.Fatalf("newSQLStore: %v", err)
+		}
+		t.Cleanup(func() { store.Close() })
+		return store
+	})
+}
@@ -0,0 +1,138 @@
+// Package service owns the User domain model, the UserService business
+// logic, and the Store persistence abstraction. Transport (REST, UI, gRPC)
+// lives in sibling packages and only ever talks to UserService.
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+// tracer's spans are started from r.Context(), so they're automatically
+// children of whatever span middleware.Tracing started for the request.
+var tracer = otel.Tracer("usersvc/service")
+
+// User represents a user in the system
+type User struct {
+	ID           int       `json:"id" db:"id"`
+	Name         string    `json:"name" db:"name"`
+	Email        string    `json:"email" db:"email"`
+	Active       bool      `json:"active" db:"active"`
+	Roles        []string  `json:"roles" db:"roles"`
+	PasswordHash string    `json:"-" db:"password_hash"`
+	Created      time.Time `json:"created" db:"created_at"`
+	Updated      time.Time `json:"updated" db:"updated_at"`
+}
+
+// storedUser is the on-disk twin of User for drivers (badger, redis) that
+// serialize the whole record as one JSON blob. PasswordHash is json:"-" on
+// User so it never leaks into an API response or a client-supplied body;
+// storedUser re-exposes it under its own tag so those drivers don't silently
+// drop it on every round trip.
+type storedUser struct {
+	User
+	PasswordHash string `json:"password_hash"`
+}
+
+func toStoredUser(user *User) storedUser {
+	stored := storedUser{User: *user, PasswordHash: user.PasswordHash}
+	stored.User.PasswordHash = ""
+	return stored
+}
+
+func (s storedUser) toUser() *User {
+	user := s.User
+	user.PasswordHash = s.PasswordHash
+	return &user
+}
+
+// UserService defines the interface for user operations
+type UserService interface {
+	CreateUser(ctx context.Context, user *User) error
+	GetUser(ctx context.Context, id int) (*User, error)
+	UpdateUser(ctx context.Context, user *User) error
+	DeleteUser(ctx context.Context, id int) error
+	ListUsers(ctx context.Context, limit, offset int) ([]*User, error)
+
+	// ListUsersPage is the cursor-paginated alternative to ListUsers: pass
+	// the previous call's nextCursor to fetch the following page. An empty
+	// nextCursor means there is no more data.
+	ListUsersPage(ctx context.Context, cursor string, limit int) (users []*User, nextCursor string, err error)
+}
+
+// userService implements UserService on top of a pluggable Store, so the
+// business rules below (timestamps, defaults) are shared by every driver.
+type userService struct {
+	store Store
+}
+
+// NewUserService creates a new user service backed by store.
+func NewUserService(store Store) UserService {
+	return &userService{store: store}
+}
+
+func (s *userService) CreateUser(ctx context.Context, user *User) error {
+	ctx, span := tracer.Start(ctx, "UserService.CreateUser")
+	defer span.End()
+
+	user.Created = time.Now()
+	user.Updated = time.Now()
+	user.Active = true
+	if len(user.Roles) == 0 {
+		user.Roles = []string{"user"}
+	}
+
+	return s.store.Insert(ctx, user)
+}
+
+func (s *userService) GetUser(ctx context.Context, id int) (*User, error) {
+	ctx, span := tracer.Start(ctx, "UserService.GetUser")
+	defer span.End()
+
+	return s.store.Find(ctx, id)
+}
+
+func (s *userService) UpdateUser(ctx context.Context, user *User) error {
+	ctx, span := tracer.Start(ctx, "UserService.UpdateUser")
+	defer span.End()
+
+	user.Updated = time.Now()
+	return s.store.Save(ctx, user)
+}
+
+func (s *userService) DeleteUser(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "UserService.DeleteUser")
+	defer span.End()
+
+	return s.store.Remove(ctx, id)
+}
+
+func (s *userService) ListUsers(ctx context.Context, limit, offset int) ([]*User, error) {
+	ctx, span := tracer.Start(ctx, "UserService.ListUsers")
+	defer span.End()
+
+	return s.store.FindAll(ctx, limit, offset)
+}
+
+func (s *userService) ListUsersPage(ctx context.Context, cursor string, limit int) ([]*User, string, error) {
+	ctx, span := tracer.Start(ctx, "UserService.ListUsersPage")
+	defer span.End()
+
+	afterID, err := DecodeCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	users, err := s.store.FindPage(ctx, afterID, limit)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextCursor string
+	if len(users) == limit {
+		nextCursor = EncodeCursor(users[len(users)-1].ID)
+	}
+	return users, nextCursor, nil
+}
@@ -0,0 +1,83 @@
+// Package ui serves a small browsable HTML front end for UserService over
+// the same data the REST API exposes, mirroring the api/v1 package but
+// rendering html/template views instead of JSON.
+package ui
+
+import (
+	"embed"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"html/template"
+
+	"github.com/gorilla/mux"
+
+	"usersvc/auth"
+	"usersvc/service"
+)
+
+//go:embed templates/*.html
+var templatesFS embed.FS
+
+// helperFuncs are the Sprig-style template helpers our views use.
+var helperFuncs = template.FuncMap{
+	"join": func(items []string, sep string) string { return strings.Join(items, sep) },
+}
+
+// Handler renders the user management UI.
+type Handler struct {
+	service   service.UserService
+	templates *template.Template
+}
+
+// NewHandler creates a new UI handler, parsing the embedded templates.
+func NewHandler(svc service.UserService) (*Handler, error) {
+	tmpl, err := template.New("ui").Funcs(helperFuncs).ParseFS(templatesFS, "templates/*.html")
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{service: svc, templates: tmpl}, nil
+}
+
+func (h *Handler) usersList(w http.ResponseWriter, r *http.Request) {
+	users, err := h.service.ListUsers(r.Context(), 100, 0)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := h.templates.ExecuteTemplate(w, "users-list.html", struct{ Users []*service.User }{users}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (h *Handler) userInfo(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		return
+	}
+
+	user, err := h.service.GetUser(r.Context(), id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := h.templates.ExecuteTemplate(w, "user-info.html", struct{ User *service.User }{user}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Routes mounts the browsable UI under /ui. It exposes the same user data
+// as the REST API's admin-only routes, so it requires a valid bearer token
+// and the admin role just like those do.
+func Routes(r *mux.Router, handler *Handler, issuer *auth.Issuer) {
+	uiRoutes := r.PathPrefix("/ui").Subrouter()
+	uiRoutes.Use(auth.Authenticate(issuer), auth.RequireRole("admin"))
+	uiRoutes.HandleFunc("/users", handler.usersList).Methods("GET")
+	uiRoutes.HandleFunc("/users/{id:[0-9]+}", handler.userInfo).Methods("GET")
+}